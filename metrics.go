@@ -0,0 +1,35 @@
+package worker
+
+import "time"
+
+// OutcomeType describes how a single worker run ended
+type OutcomeType string
+
+const (
+	OutcomeSuccess OutcomeType = "success"
+	OutcomeError   OutcomeType = "error"
+	OutcomeTimeout OutcomeType = "timeout"
+	OutcomeSkipped OutcomeType = "skipped"
+)
+
+// SkipReasonLocked is used when run was skipped because another instance holds the lock
+const SkipReasonLocked = "locked"
+
+// RunOutcome contains everything known about a single worker run
+type RunOutcome struct {
+	Worker   string
+	Type     OutcomeType
+	Duration time.Duration
+	// Attempt is the tick number of the worker starting from 1. Skipped ticks are counted too
+	Attempt uint64
+	// Reason is the skip reason for skipped runs and the error message for failed or timed out runs
+	Reason string
+	Err    error
+	// Labels is a copy of worker labels and could be changed by collector
+	Labels map[string]string
+}
+
+// MetricsCollector receives outcome of every worker run
+type MetricsCollector interface {
+	Observe(outcome RunOutcome)
+}
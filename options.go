@@ -0,0 +1,56 @@
+package worker
+
+import "time"
+
+// Option configures [Worker] created by [RunWith]
+type Option func(worker *Worker)
+
+// WithFromStart sets flag for starting worker from start.
+//
+// It is the same as bool variadic argument of [Run]
+func WithFromStart(fromStart bool) Option {
+	return func(worker *Worker) {
+		worker.FromStart(fromStart)
+	}
+}
+
+// WithTimeout sets timeout duration for working action timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(worker *Worker) {
+		worker.Timeout(timeout)
+	}
+}
+
+// WithErrorHandler sets custom error handler from action
+func WithErrorHandler(handler func(error) bool) Option {
+	return func(worker *Worker) {
+		worker.ErrorHandler(handler)
+	}
+}
+
+// WithLocker wraps every run with acquiring and releasing provided lock
+func WithLocker(locker *Locker) Option {
+	return func(worker *Worker) {
+		if locker == nil {
+			return
+		}
+
+		worker.
+			BeforeMiddlewares(LockMiddleware(locker)).
+			AfterMiddlewares(UnlockMiddleware(locker))
+	}
+}
+
+// WithLabels adds labels passed with every [RunOutcome]
+func WithLabels(labels map[string]string) Option {
+	return func(worker *Worker) {
+		worker.Labels(labels)
+	}
+}
+
+// WithMetrics sets collector which observes outcome of every run
+func WithMetrics(collector MetricsCollector) Option {
+	return func(worker *Worker) {
+		worker.Metrics(collector)
+	}
+}
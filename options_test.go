@@ -0,0 +1,215 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// capture returns option which stores created worker, so test could wait for it
+func capture(worker **Worker) Option {
+	return func(w *Worker) {
+		*worker = w
+	}
+}
+
+func TestRunWithErrorHandler(t *testing.T) {
+	errAction := errors.New("action failed")
+
+	var (
+		worker  *Worker
+		handled error
+	)
+	RunWith("error handler", time.Hour, func(ctx context.Context) error {
+		return errAction
+	},
+		WithFromStart(true),
+		WithErrorHandler(func(err error) bool {
+			handled = err
+			return false
+		}),
+		capture(&worker),
+	)
+
+	select {
+	case <-worker.done:
+	case <-time.After(time.Second):
+		t.Fatal("worker was not stopped by error handler")
+	}
+
+	if !errors.Is(handled, errAction) {
+		t.Errorf("handled error: got %v, want %v", handled, errAction)
+	}
+}
+
+// stop stops worker created by [RunWith] and waits till it is done
+func stop(t *testing.T, worker *Worker) {
+	t.Helper()
+
+	worker.stopper <- struct{}{}
+	select {
+	case <-worker.done:
+	case <-time.After(time.Second):
+		t.Fatal("worker was not stopped")
+	}
+}
+
+func TestRunWithOptions(t *testing.T) {
+	var (
+		worker *Worker
+		calls  int
+	)
+	collector := &fakeCollector{}
+
+	RunWith("options", time.Hour, func(ctx context.Context) error {
+		calls++
+		return nil
+	},
+		WithFromStart(true),
+		WithLabels(map[string]string{"team": "core"}),
+		WithMetrics(collector),
+		capture(&worker),
+	)
+	stop(t, worker)
+
+	if calls != 1 {
+		t.Fatalf("action calls with from start: got %d, want 1", calls)
+	}
+
+	outcome := collector.last(t)
+	if outcome.Worker != "options" {
+		t.Errorf("worker: got %q, want %q", outcome.Worker, "options")
+	}
+	if outcome.Type != OutcomeSuccess {
+		t.Errorf("type: got %q, want %q", outcome.Type, OutcomeSuccess)
+	}
+	if outcome.Labels["team"] != "core" {
+		t.Errorf("labels: got %v, want team=core", outcome.Labels)
+	}
+}
+
+func TestRunWithTimeout(t *testing.T) {
+	var worker *Worker
+	collector := &fakeCollector{}
+
+	RunWith("timeout", time.Hour, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	},
+		WithFromStart(true),
+		WithTimeout(time.Millisecond),
+		WithMetrics(collector),
+		capture(&worker),
+	)
+	stop(t, worker)
+
+	outcome := collector.last(t)
+	if outcome.Type != OutcomeTimeout {
+		t.Errorf("type: got %q, want %q", outcome.Type, OutcomeTimeout)
+	}
+	if !errors.Is(outcome.Err, context.DeadlineExceeded) {
+		t.Errorf("err: got %v, want %v", outcome.Err, context.DeadlineExceeded)
+	}
+}
+
+func TestRunWithoutFromStart(t *testing.T) {
+	var (
+		worker *Worker
+		calls  int
+	)
+
+	RunWith("not from start", time.Hour, func(ctx context.Context) error {
+		calls++
+		return nil
+	},
+		WithFromStart(false),
+		capture(&worker),
+	)
+	stop(t, worker)
+
+	if calls != 0 {
+		t.Errorf("action calls without from start: got %d, want 0", calls)
+	}
+}
+
+func TestRunFromStart(t *testing.T) {
+	calls := make(chan struct{}, 1)
+
+	// bool variadic is kept for backward compatibility
+	Run("run from start", time.Hour, func(ctx context.Context) error {
+		calls <- struct{}{}
+		return nil
+	}, true)
+
+	select {
+	case <-calls:
+	default:
+		t.Fatal("action was not called from start")
+	}
+}
+
+func TestRunWithErrorHandlerOnTick(t *testing.T) {
+	errAction := errors.New("action failed")
+	handled := make(chan error, 1)
+
+	var worker *Worker
+	RunWith("error handler tick", 10*time.Millisecond, func(ctx context.Context) error {
+		return errAction
+	},
+		WithErrorHandler(func(err error) bool {
+			select {
+			case handled <- err:
+			default:
+			}
+			return false
+		}),
+		capture(&worker),
+	)
+
+	select {
+	case err := <-handled:
+		if !errors.Is(err, errAction) {
+			t.Errorf("handled error: got %v, want %v", err, errAction)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("error handler was not called on tick")
+	}
+
+	select {
+	case <-worker.done:
+	case <-time.After(time.Second):
+		t.Fatal("worker was not stopped by error handler")
+	}
+}
+
+func TestRunWithLocker(t *testing.T) {
+	cases := []struct {
+		name   string
+		locker *Locker
+		want   int
+	}{
+		{name: "locker", locker: NewLocker(nil, "locker", time.Minute), want: 1},
+		{name: "nil locker", locker: nil, want: 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var worker *Worker
+			RunWith(tc.name, time.Hour, func(ctx context.Context) error {
+				return nil
+			},
+				WithLocker(tc.locker),
+				capture(&worker),
+			)
+			stop(t, worker)
+
+			if len(worker.beforeMiddlewares) != tc.want {
+				t.Errorf("before middlewares: got %d, want %d", len(worker.beforeMiddlewares), tc.want)
+			}
+			if len(worker.afterMiddlewares) != tc.want {
+				t.Errorf("after middlewares: got %d, want %d", len(worker.afterMiddlewares), tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package worker
+
+import "time"
+
+// SkipReasonBlackout is used when run was skipped because tick happened during blackout
+const SkipReasonBlackout = "blackout"
+
+// TimeRange is a calendar range [From, To) during which worker must not run
+type TimeRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// Contains checks if provided time is inside of range
+func (r TimeRange) Contains(t time.Time) bool {
+	return !t.Before(r.From) && t.Before(r.To)
+}
+
+// rangesContain checks if provided time is inside of any range
+func rangesContain(ranges []TimeRange, t time.Time) bool {
+	for _, r := range ranges {
+		if r.Contains(t) {
+			return true
+		}
+	}
+
+	return false
+}
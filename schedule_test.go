@@ -0,0 +1,183 @@
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTimeRangeContains(t *testing.T) {
+	from := time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	r := TimeRange{From: from, To: to}
+
+	cases := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{name: "before", at: from.Add(-time.Nanosecond), want: false},
+		{name: "from", at: from, want: true},
+		{name: "inside", at: from.Add(time.Hour), want: true},
+		{name: "before to", at: to.Add(-time.Nanosecond), want: true},
+		{name: "to", at: to, want: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := r.Contains(tc.at); got != tc.want {
+				t.Errorf("Contains(%s): got %v, want %v", tc.at, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBlackout(t *testing.T) {
+	var (
+		calls    atomic.Int32
+		inFreeze atomic.Bool
+	)
+	collector := &fakeCollector{}
+
+	worker := NewWorker("blackout", time.Hour, func(ctx context.Context) error {
+		calls.Add(1)
+		return nil
+	}).
+		BlackoutFunc(func(time.Time) bool { return inFreeze.Load() }).
+		Metrics(collector)
+
+	inFreeze.Store(true)
+	_ = worker.runAction()
+
+	if calls.Load() != 0 {
+		t.Fatalf("action called during blackout")
+	}
+
+	outcome := collector.last(t)
+	if outcome.Type != OutcomeSkipped {
+		t.Errorf("type: got %q, want %q", outcome.Type, OutcomeSkipped)
+	}
+	if outcome.Reason != SkipReasonBlackout {
+		t.Errorf("reason: got %q, want %q", outcome.Reason, SkipReasonBlackout)
+	}
+
+	inFreeze.Store(false)
+	_ = worker.runAction()
+
+	if calls.Load() != 1 {
+		t.Fatalf("action calls after blackout: got %d, want 1", calls.Load())
+	}
+
+	outcome = collector.last(t)
+	if outcome.Type != OutcomeSuccess {
+		t.Errorf("type: got %q, want %q", outcome.Type, OutcomeSuccess)
+	}
+	if outcome.Attempt != 2 {
+		t.Errorf("attempt: got %d, want 2", outcome.Attempt)
+	}
+}
+
+func TestBlackoutSkipsLockMiddlewares(t *testing.T) {
+	var before, after atomic.Int32
+
+	worker := NewWorker("blackout lock", time.Hour, func(ctx context.Context) error {
+		return nil
+	}).
+		BlackoutFunc(func(time.Time) bool { return true }).
+		BeforeMiddlewares(func(ctx context.Context) error {
+			before.Add(1)
+			return nil
+		}).
+		AfterMiddlewares(func(ctx context.Context) error {
+			after.Add(1)
+			return nil
+		})
+
+	_ = worker.runAction()
+
+	if before.Load() != 0 || after.Load() != 0 {
+		t.Errorf("middlewares called during blackout: before %d, after %d", before.Load(), after.Load())
+	}
+}
+
+func TestBlackoutRanges(t *testing.T) {
+	at := time.Date(2025, time.December, 31, 12, 0, 0, 0, time.UTC)
+	first := TimeRange{From: at.Add(-time.Hour), To: at.Add(time.Hour)}
+	second := TimeRange{From: at.Add(24 * time.Hour), To: at.Add(48 * time.Hour)}
+
+	ranges := []TimeRange{first}
+	worker := NewWorker("blackout ranges", time.Hour, func(ctx context.Context) error {
+		return nil
+	}).
+		Blackout(ranges).
+		Blackout([]TimeRange{second})
+
+	// changing caller slice must not change worker blackout
+	ranges[0] = TimeRange{}
+
+	if !worker.inBlackout(at) {
+		t.Errorf("first range is not in blackout")
+	}
+	if !worker.inBlackout(second.From) {
+		t.Errorf("second range is not in blackout")
+	}
+	if worker.inBlackout(at.Add(12 * time.Hour)) {
+		t.Errorf("time between ranges is in blackout")
+	}
+}
+
+func TestBlackoutFuncNil(t *testing.T) {
+	worker := NewWorker("blackout func", time.Hour, func(ctx context.Context) error {
+		return nil
+	}).
+		BlackoutFunc(func(time.Time) bool { return true })
+
+	if !worker.inBlackout(time.Now()) {
+		t.Fatalf("predicate is not applied")
+	}
+
+	worker.BlackoutFunc(nil)
+
+	if worker.inBlackout(time.Now()) {
+		t.Errorf("nil predicate did not remove blackout")
+	}
+}
+
+func TestBlackoutRetainLock(t *testing.T) {
+	var calls, before, after atomic.Int32
+	collector := &fakeCollector{}
+
+	worker := NewWorker("blackout retain lock", time.Hour, func(ctx context.Context) error {
+		calls.Add(1)
+		return nil
+	}).
+		BlackoutFunc(func(time.Time) bool { return true }).
+		BlackoutRetainLock(true).
+		BeforeMiddlewares(func(ctx context.Context) error {
+			before.Add(1)
+			return nil
+		}).
+		AfterMiddlewares(func(ctx context.Context) error {
+			after.Add(1)
+			return nil
+		}).
+		Metrics(collector)
+
+	_ = worker.runAction()
+
+	if before.Load() != 1 || after.Load() != 1 {
+		t.Errorf("middlewares during blackout: before %d, after %d, want 1 and 1", before.Load(), after.Load())
+	}
+	if calls.Load() != 0 {
+		t.Errorf("action called during blackout")
+	}
+
+	outcome := collector.last(t)
+	if outcome.Type != OutcomeSkipped {
+		t.Errorf("type: got %q, want %q", outcome.Type, OutcomeSkipped)
+	}
+	if outcome.Reason != SkipReasonBlackout {
+		t.Errorf("reason: got %q, want %q", outcome.Reason, SkipReasonBlackout)
+	}
+}
@@ -28,6 +28,12 @@ type Worker struct {
 	done         chan struct{}
 	timeout      time.Duration
 	amIMaster    bool
+	metrics      MetricsCollector
+	blackout     []TimeRange
+	blackoutFunc func(time.Time) bool
+	retainLock   bool
+	labels       map[string]string
+	attempt      uint64
 
 	beforeMiddlewares []Middleware
 	afterMiddlewares  []Middleware
@@ -81,6 +87,74 @@ func (worker *Worker) ErrorHandler(handler func(error) bool) *Worker {
 	return worker
 }
 
+// Metrics sets collector which observes outcome of every run
+func (worker *Worker) Metrics(collector MetricsCollector) *Worker {
+	worker.metrics = collector
+	return worker
+}
+
+// Labels adds labels passed with every [RunOutcome]
+func (worker *Worker) Labels(labels map[string]string) *Worker {
+	if len(labels) == 0 {
+		return worker
+	}
+
+	if worker.labels == nil {
+		worker.labels = make(map[string]string, len(labels))
+	}
+
+	for key, value := range labels {
+		worker.labels[key] = value
+	}
+
+	return worker
+}
+
+// Blackout adds calendar ranges during which ticks are skipped.
+//
+// Ranges are copied and appended to previously added ones.
+//
+// By default blackout never takes the lock: lock middlewares are not run for skipped ticks,
+// so lock is neither acquired nor renewed and other instances are free to take it.
+// Use [Worker.BlackoutRetainLock] to keep locking during blackout.
+func (worker *Worker) Blackout(ranges []TimeRange) *Worker {
+	if len(ranges) == 0 {
+		return worker
+	}
+
+	worker.blackout = append(worker.blackout, ranges...)
+	return worker
+}
+
+// BlackoutFunc sets predicate which reports if tick at provided time must be skipped.
+//
+// Predicate replaces previous one and is checked together with ranges from [Worker.Blackout].
+// Passing nil removes predicate.
+//
+// Lock behaviour is the same as for [Worker.Blackout]
+func (worker *Worker) BlackoutFunc(blackout func(time.Time) bool) *Worker {
+	worker.blackoutFunc = blackout
+	return worker
+}
+
+// BlackoutRetainLock sets flag for running middlewares during blackout.
+//
+// When set, ticks during blackout run before and after middlewares as usual (so lock
+// middlewares acquire the lock and renew it) and skip only the action.
+func (worker *Worker) BlackoutRetainLock(retainLock bool) *Worker {
+	worker.retainLock = retainLock
+	return worker
+}
+
+// inBlackout checks if tick at provided time must be skipped
+func (worker *Worker) inBlackout(t time.Time) bool {
+	if rangesContain(worker.blackout, t) {
+		return true
+	}
+
+	return worker.blackoutFunc != nil && worker.blackoutFunc(t)
+}
+
 func (worker *Worker) BeforeMiddlewares(middlewares ...Middleware) *Worker {
 	if len(middlewares) == 0 {
 		return worker
@@ -100,6 +174,8 @@ func (worker *Worker) AfterMiddlewares(middlewares ...Middleware) *Worker {
 }
 
 // runAction runs provided action with context and try function and trace id.
+//
+// Returns action error, so it could be passed to error handler.
 func (worker *Worker) runAction() error {
 	ctx := context.Background()
 	var cancel context.CancelFunc
@@ -113,8 +189,18 @@ func (worker *Worker) runAction() error {
 		defer cancel()
 	}
 
-	if err := errorx.TryContext(ctx, func(ctx context.Context) error {
-		var locked bool
+	worker.attempt++
+	startedAt := time.Now()
+
+	// by default blackout never takes the lock, so middlewares are not run at all
+	blackout := worker.inBlackout(startedAt)
+	if blackout && !worker.retainLock {
+		worker.observe(worker.outcome(ctx, startedAt, false, true, nil))
+		return nil
+	}
+
+	var locked bool
+	err := errorx.TryContext(ctx, func(ctx context.Context) error {
 		for _, middleware := range worker.beforeMiddlewares {
 			if locked {
 				break
@@ -150,12 +236,13 @@ func (worker *Worker) runAction() error {
 			}
 		}()
 
-		if locked {
+		if locked || blackout {
 			return nil
 		}
 
 		return worker.action(ctx)
-	}); err != nil {
+	})
+	if err != nil {
 		log.
 			Namespace(worker.name).
 			Error().
@@ -163,7 +250,65 @@ func (worker *Worker) runAction() error {
 			Msg("Worker action failed")
 	}
 
-	return nil
+	worker.observe(worker.outcome(ctx, startedAt, locked, blackout, err))
+	return err
+}
+
+// outcome builds [RunOutcome] from the result of a single run
+func (worker *Worker) outcome(
+	ctx context.Context,
+	startedAt time.Time,
+	locked, blackout bool,
+	err error,
+) RunOutcome {
+	outcome := RunOutcome{
+		Worker:   worker.name,
+		Type:     OutcomeSuccess,
+		Duration: time.Since(startedAt),
+		Attempt:  worker.attempt,
+		Labels:   worker.copyLabels(),
+	}
+
+	switch {
+	case blackout:
+		outcome.Type = OutcomeSkipped
+		outcome.Reason = SkipReasonBlackout
+	case locked:
+		outcome.Type = OutcomeSkipped
+		outcome.Reason = SkipReasonLocked
+	case err != nil:
+		outcome.Type = OutcomeError
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			outcome.Type = OutcomeTimeout
+		}
+		outcome.Reason = err.Error()
+		outcome.Err = err
+	}
+
+	return outcome
+}
+
+// copyLabels returns copy of worker labels, so collectors could not change worker state
+func (worker *Worker) copyLabels() map[string]string {
+	if len(worker.labels) == 0 {
+		return nil
+	}
+
+	labels := make(map[string]string, len(worker.labels))
+	for key, value := range worker.labels {
+		labels[key] = value
+	}
+
+	return labels
+}
+
+// observe passes run outcome to metrics collector if it is set
+func (worker *Worker) observe(outcome RunOutcome) {
+	if worker.metrics == nil {
+		return
+	}
+
+	worker.metrics.Observe(outcome)
 }
 
 // Run runs worker with provided duration
@@ -216,10 +361,27 @@ func Run(
 	duration time.Duration,
 	action Action,
 	fromStart ...bool,
+) {
+	if len(fromStart) == 0 {
+		RunWith(name, duration, action)
+		return
+	}
+
+	RunWith(name, duration, action, WithFromStart(fromStart[0]))
+}
+
+// RunWith is like [Run] but worker is configured by provided options
+func RunWith(
+	name string,
+	duration time.Duration,
+	action Action,
+	opts ...Option,
 ) {
 	worker := NewWorker(name, duration, action)
-	if len(fromStart) > 0 {
-		worker.FromStart(fromStart[0])
+	for _, opt := range opts {
+		if opt != nil {
+			opt(worker)
+		}
 	}
 	worker.Run()
 }
@@ -0,0 +1,139 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeCollector struct {
+	mx       sync.Mutex
+	outcomes []RunOutcome
+}
+
+func (c *fakeCollector) Observe(outcome RunOutcome) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.outcomes = append(c.outcomes, outcome)
+}
+
+func (c *fakeCollector) all() []RunOutcome {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return append([]RunOutcome(nil), c.outcomes...)
+}
+
+func (c *fakeCollector) last(t *testing.T) RunOutcome {
+	t.Helper()
+
+	outcomes := c.all()
+	if len(outcomes) == 0 {
+		t.Fatal("collector did not receive any outcome")
+	}
+
+	return outcomes[len(outcomes)-1]
+}
+
+func TestRunActionOutcome(t *testing.T) {
+	errAction := errors.New("action failed")
+
+	cases := []struct {
+		name        string
+		action      Action
+		timeout     time.Duration
+		middlewares []Middleware
+		wantType    OutcomeType
+		wantReason  string
+		wantErr     error
+	}{
+		{
+			name:     "success",
+			action:   func(ctx context.Context) error { return nil },
+			wantType: OutcomeSuccess,
+		},
+		{
+			name:       "error",
+			action:     func(ctx context.Context) error { return errAction },
+			wantType:   OutcomeError,
+			wantReason: errAction.Error(),
+			wantErr:    errAction,
+		},
+		{
+			name: "timeout",
+			action: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			timeout:    time.Millisecond,
+			wantType:   OutcomeTimeout,
+			wantReason: context.DeadlineExceeded.Error(),
+			wantErr:    context.DeadlineExceeded,
+		},
+		{
+			name:   "locked",
+			action: func(ctx context.Context) error { return errAction },
+			middlewares: []Middleware{
+				func(ctx context.Context) error { return ErrLocked },
+			},
+			wantType:   OutcomeSkipped,
+			wantReason: SkipReasonLocked,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			collector := &fakeCollector{}
+			worker := NewWorker(tc.name, time.Hour, tc.action).
+				Timeout(tc.timeout).
+				BeforeMiddlewares(tc.middlewares...).
+				Metrics(collector)
+
+			for attempt := uint64(1); attempt <= 2; attempt++ {
+				_ = worker.runAction()
+
+				outcome := collector.last(t)
+				if outcome.Worker != tc.name {
+					t.Errorf("worker: got %q, want %q", outcome.Worker, tc.name)
+				}
+				if outcome.Type != tc.wantType {
+					t.Errorf("type: got %q, want %q", outcome.Type, tc.wantType)
+				}
+				if outcome.Reason != tc.wantReason {
+					t.Errorf("reason: got %q, want %q", outcome.Reason, tc.wantReason)
+				}
+				if outcome.Attempt != attempt {
+					t.Errorf("attempt: got %d, want %d", outcome.Attempt, attempt)
+				}
+				if !errors.Is(outcome.Err, tc.wantErr) {
+					t.Errorf("err: got %v, want %v", outcome.Err, tc.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestRunActionOutcomeLabelsCopy(t *testing.T) {
+	collector := &fakeCollector{}
+	worker := NewWorker("labels", time.Hour, func(ctx context.Context) error {
+		return nil
+	}).
+		Labels(map[string]string{"team": "core"}).
+		Metrics(collector)
+
+	_ = worker.runAction()
+
+	first := collector.last(t)
+	first.Labels["team"] = "changed"
+	worker.Labels(map[string]string{"env": "prod"})
+
+	if worker.labels["team"] != "core" {
+		t.Errorf("collector changed worker labels: %v", worker.labels)
+	}
+	if _, ok := first.Labels["env"]; ok {
+		t.Errorf("sent outcome got labels set later: %v", first.Labels)
+	}
+}